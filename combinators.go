@@ -0,0 +1,62 @@
+package validate
+
+// validatorFunc adapts a plain func() error to Validatable, the way each
+// combinator in this file builds its return value.
+type validatorFunc func() error
+
+func (f validatorFunc) Validate() error { return f() }
+
+// Any passes if at least one of vs succeeds. If all fail, it returns a
+// ValidationError collecting every failure.
+func Any(vs ...Validatable) Validatable {
+	return validatorFunc(func() error {
+		if len(vs) == 0 {
+			return nil
+		}
+		var errs []error
+		for _, v := range vs {
+			err := v.Validate()
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return ValidationError{Errors: errs}
+	})
+}
+
+// First runs vs in order and returns the first failure, skipping the rest. Use it
+// when a later validator only makes sense once an earlier one has passed.
+func First(vs ...Validatable) Validatable {
+	return validatorFunc(func() error {
+		for _, v := range vs {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// When runs vs only if cond holds, e.g. "salary must be > 0 only if
+// employment_type is full_time".
+func When(cond bool, vs ...Validatable) Validatable {
+	return validatorFunc(func() error {
+		if !cond {
+			return nil
+		}
+		return All(vs...)
+	})
+}
+
+// OmitEmpty skips vs when value is the zero value for T, mirroring the omitempty
+// struct tag for the programmatic API.
+func OmitEmpty[T comparable](value T, vs ...Validatable) Validatable {
+	var zero T
+	return validatorFunc(func() error {
+		if value == zero {
+			return nil
+		}
+		return All(vs...)
+	})
+}
@@ -0,0 +1,118 @@
+package validate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nikolaydubina/validate"
+)
+
+func TestAny(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   []validate.Validatable
+		want bool
+	}{
+		{"all fail", []validate.Validatable{validate.Min[int]{Value: 1, Min: 5}, validate.Max[int]{Value: 10, Max: 5}}, false},
+		{"one passes", []validate.Validatable{validate.Min[int]{Value: 1, Min: 5}, validate.Max[int]{Value: 1, Max: 5}}, true},
+		{"empty", nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.Any(tc.vs...).Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestFirst(t *testing.T) {
+	err := validate.First(
+		validate.Min[int]{Value: 1, Min: 5},
+		validate.Max[int]{Value: 100, Max: 5},
+	).Validate()
+	var me validate.Min[int]
+	if !errors.As(err, &me) {
+		t.Fatalf("want first failure to be Min, got %v", err)
+	}
+}
+
+func TestFirst_AllPass(t *testing.T) {
+	err := validate.First(
+		validate.Min[int]{Value: 10, Min: 5},
+		validate.Max[int]{Value: 1, Max: 5},
+	).Validate()
+	if err != nil {
+		t.Errorf("got error %v, want nil", err)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	tests := []struct {
+		name string
+		cond bool
+		want bool
+	}{
+		{"cond false skips", false, true},
+		{"cond true runs", true, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.When(tc.cond, validate.Min[int]{Value: 1, Min: 5}).Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestOmitEmpty(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{"zero value skips", "", true},
+		{"non-zero value runs", "full_time", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.OmitEmpty(tc.value, validate.Min[int]{Value: 0, Min: 1}).Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
+
+type Position struct {
+	EmploymentType string
+	Salary         int
+}
+
+func (p Position) Validate() error {
+	return validate.All(
+		validate.When(p.EmploymentType == "full_time", validate.Min[int]{Name: "Salary", Value: p.Salary, Min: 1}),
+	)
+}
+
+func TestPosition_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Position
+		want bool
+	}{
+		{"full time needs salary", Position{EmploymentType: "full_time", Salary: 0}, false},
+		{"part time salary optional", Position{EmploymentType: "part_time", Salary: 0}, true},
+		{"full time with salary", Position{EmploymentType: "full_time", Salary: 50000}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
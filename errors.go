@@ -0,0 +1,135 @@
+package validate
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Kind discriminates the two shapes a Field can take: a single validator failure,
+// or a nested ValidationError (e.g. the one a sub-struct's own Validate raised).
+type Kind string
+
+const (
+	KindLeaf   Kind = "leaf"
+	KindNested Kind = "nested"
+)
+
+// Field is a single structured validation failure, suitable for building
+// field-level UI feedback or a JSON API response. Tag, Value, and Param are only
+// populated for leaf failures. Value and Param are left zero for errors that carry
+// no such fields (e.g. errors.New); Tag is then the error's lowercased type name
+// (see describe).
+type Field struct {
+	Field  string  `json:"field,omitempty"`
+	Tag    string  `json:"tag,omitempty"`
+	Value  any     `json:"value,omitempty"`
+	Param  any     `json:"param,omitempty"`
+	Kind   Kind    `json:"kind"`
+	Nested []Field `json:"nested,omitempty"`
+}
+
+// Fields flattens e into structured failures. A nested ValidationError holding two
+// or more errors (for example the one returned by a sub-struct's own Validate
+// method) is preserved under Nested rather than flattened, so callers can tell which
+// field it came from. A nested ValidationError holding a single error is not kept
+// as a Nested layer: it is reported as that error's own entry, with Field set to the
+// enclosing field path when there is one.
+func (e ValidationError) Fields() []Field {
+	fields := make([]Field, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		fields = append(fields, fieldOf(err))
+	}
+	return fields
+}
+
+// MarshalJSON renders e as its structured Fields, so an HTTP handler can return
+// validation failures as JSON suitable for form-field-level UI feedback.
+func (e ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Fields())
+}
+
+func fieldOf(err error) Field {
+	path := ""
+	if fe, ok := err.(FieldError); ok {
+		path, err = fe.Path, fe.Err
+	}
+	if ve, ok := err.(ValidationError); ok {
+		if len(ve.Errors) == 1 {
+			// A single-error wrapper (e.g. a dive over one failing element, or a
+			// sub-struct with exactly one field failure) carries no information a
+			// genuine multi-error Nested does, so collapse it instead of reporting a
+			// phantom nesting layer with an empty field and no real tag.
+			f := fieldOf(ve.Errors[0])
+			if path != "" {
+				f.Field = path
+			}
+			return f
+		}
+		return Field{Field: path, Kind: KindNested, Nested: ve.Fields()}
+	}
+	tag, value, param := describe(err)
+	if path == "" {
+		path = nameOf(err)
+	}
+	return Field{Field: path, Tag: tag, Value: value, Param: param, Kind: KindLeaf}
+}
+
+// nameOf falls back to a validator error's own Name field for Field.Field when it
+// wasn't produced through Struct (and so has no reflected path), preserving the
+// hand-written names passed to OneOf, Min, and friends in the programmatic API.
+func nameOf(err error) string {
+	rv := reflect.ValueOf(err)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := rv.FieldByName("Name"); f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// Tagger is implemented by validator types that know their own stable tag name
+// (e.g. "min", "oneof"), as used by Fields and TranslatedError.
+type Tagger interface {
+	Tag() string
+}
+
+// describe extracts a stable tag name plus the failed value and configured bound
+// from a validator error. The tag comes from Tag() when the error implements
+// Tagger; otherwise it falls back to the error's lowercased type name. Value and
+// Param are read off whichever exported fields the error struct carries: Value,
+// and the first present of Min, Max, Values, Time, Other, N, Pattern, Chars,
+// Prefix, or Suffix.
+func describe(err error) (tag string, value, param any) {
+	rv := reflect.ValueOf(err)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", nil, nil
+	}
+
+	if t, ok := err.(Tagger); ok {
+		tag = t.Tag()
+	} else {
+		tag = strings.ToLower(rv.Type().Name())
+		if i := strings.IndexByte(tag, '['); i >= 0 {
+			tag = tag[:i]
+		}
+	}
+
+	if f := rv.FieldByName("Value"); f.IsValid() {
+		value = f.Interface()
+	}
+	for _, name := range []string{"Min", "Max", "Values", "Time", "Other", "N", "Pattern", "Chars", "Prefix", "Suffix"} {
+		if f := rv.FieldByName(name); f.IsValid() {
+			param = f.Interface()
+			break
+		}
+	}
+	return tag, value, param
+}
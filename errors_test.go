@@ -0,0 +1,75 @@
+package validate_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nikolaydubina/validate"
+)
+
+func TestValidationError_Fields(t *testing.T) {
+	err := validate.Struct(Candidate{Age: 10, Degrees: []Degree{{School: "Berkeley"}}})
+	ve, ok := err.(validate.ValidationError)
+	if !ok {
+		t.Fatalf("got %T, want validate.ValidationError", err)
+	}
+
+	fields := ve.Fields()
+	if len(fields) == 0 {
+		t.Fatal("got no fields, want at least one failure")
+	}
+
+	leaf := findField(fields, "Candidate.Degrees[0].School")
+	if leaf == nil {
+		t.Fatalf("got %+v, want a leaf field for Candidate.Degrees[0].School", fields)
+	}
+	if leaf.Kind != validate.KindLeaf || leaf.Tag != "oneof" {
+		t.Errorf("got %+v, want leaf oneof failure", leaf)
+	}
+}
+
+func findField(fields []validate.Field, path string) *validate.Field {
+	for i := range fields {
+		if fields[i].Field == path {
+			return &fields[i]
+		}
+		if found := findField(fields[i].Nested, path); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	err := validate.Struct(Candidate{})
+	ve, ok := err.(validate.ValidationError)
+	if !ok {
+		t.Fatalf("got %T, want validate.ValidationError", err)
+	}
+
+	b, jsonErr := json.Marshal(ve)
+	if jsonErr != nil {
+		t.Fatalf("got error %v", jsonErr)
+	}
+
+	var fields []validate.Field
+	if jsonErr := json.Unmarshal(b, &fields); jsonErr != nil {
+		t.Fatalf("got error unmarshaling %s: %v", b, jsonErr)
+	}
+	if len(fields) == 0 {
+		t.Fatal("got no fields decoded from JSON")
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	var e error = validate.All(validate.Min[int]{Name: "age", Value: 5, Min: 10})
+
+	var ve validate.ValidationError
+	if !errors.As(e, &ve) {
+		t.Fatal("errors.As failed to match validate.ValidationError")
+	}
+	if len(ve.Fields()) != 1 {
+		t.Errorf("got %d fields, want 1", len(ve.Fields()))
+	}
+}
@@ -0,0 +1,450 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// EqField reports a failure when Value does not equal Other, the value of another
+// field, e.g. "PasswordConfirm must equal Password".
+type EqField[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s EqField[T]) Validate() error {
+	if s.Value != s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s EqField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s EqField[T]) Tag() string { return "eqfield" }
+
+// NeField reports a failure when Value equals Other.
+type NeField[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s NeField[T]) Validate() error {
+	if s.Value == s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s NeField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s NeField[T]) Tag() string { return "nefield" }
+
+// GtField reports a failure when Value is not strictly greater than Other, e.g.
+// "VacationStart must be after Birthday".
+type GtField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s GtField[T]) Validate() error {
+	if s.Value <= s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s GtField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not greater than %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s GtField[T]) Tag() string { return "gtfield" }
+
+// LtField reports a failure when Value is not strictly smaller than Other.
+type LtField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s LtField[T]) Validate() error {
+	if s.Value >= s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s LtField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not smaller than %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s LtField[T]) Tag() string { return "ltfield" }
+
+// GteField reports a failure when Value is smaller than Other.
+type GteField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s GteField[T]) Validate() error {
+	if s.Value < s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s GteField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not greater than or equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s GteField[T]) Tag() string { return "gtefield" }
+
+// LteField reports a failure when Value is greater than Other.
+type LteField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s LteField[T]) Validate() error {
+	if s.Value > s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s LteField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not smaller than or equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s LteField[T]) Tag() string { return "ltefield" }
+
+// EqCSField is the cross-struct equivalent of EqField, for comparing against a field
+// reached via a dotted path from the struct originally passed to Struct rather than
+// a sibling on the same struct.
+type EqCSField[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s EqCSField[T]) Validate() error {
+	if s.Value != s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s EqCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s EqCSField[T]) Tag() string { return "eqcsfield" }
+
+// NeCSField is the cross-struct equivalent of NeField.
+type NeCSField[T comparable] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s NeCSField[T]) Validate() error {
+	if s.Value == s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s NeCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s NeCSField[T]) Tag() string { return "necsfield" }
+
+// GtCSField is the cross-struct equivalent of GtField.
+type GtCSField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s GtCSField[T]) Validate() error {
+	if s.Value <= s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s GtCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not greater than %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s GtCSField[T]) Tag() string { return "gtcsfield" }
+
+// LtCSField is the cross-struct equivalent of LtField.
+type LtCSField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s LtCSField[T]) Validate() error {
+	if s.Value >= s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s LtCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not smaller than %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s LtCSField[T]) Tag() string { return "ltcsfield" }
+
+// GteCSField is the cross-struct equivalent of GteField.
+type GteCSField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s GteCSField[T]) Validate() error {
+	if s.Value < s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s GteCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not greater than or equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s GteCSField[T]) Tag() string { return "gtecsfield" }
+
+// LteCSField is the cross-struct equivalent of LteField.
+type LteCSField[T constraints.Ordered] struct {
+	Name      string
+	Value     T
+	OtherName string
+	Other     T
+}
+
+func (s LteCSField[T]) Validate() error {
+	if s.Value > s.Other {
+		return s
+	}
+	return nil
+}
+
+func (s LteCSField[T]) Error() string {
+	return fmt.Sprintf("%s(%v) is not smaller than or equal to %s(%v)", s.Name, s.Value, s.OtherName, s.Other)
+}
+
+func (s LteCSField[T]) Tag() string { return "ltecsfield" }
+
+// Fields compares value against other using op ("eq", "ne", "gt", "lt", "gte", "lte"),
+// so a cross-field rule like "VacationStart must be after Birthday" can be expressed
+// inline in Validate() without reaching for the EqField/GtField/... type by name.
+func Fields[T constraints.Ordered](name string, value T, op string, otherName string, other T) Validatable {
+	switch op {
+	case "eq":
+		return EqField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "ne":
+		return NeField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "gt":
+		return GtField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "lt":
+		return LtField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "gte":
+		return GteField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "lte":
+		return LteField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	default:
+		panic("validate: unknown Fields op: " + op)
+	}
+}
+
+// CSFields is the cross-struct equivalent of Fields, for a rule such as "Hired must
+// be after Org.Founded" where the other field lives on an enclosing or unrelated
+// struct rather than alongside value.
+func CSFields[T constraints.Ordered](name string, value T, op string, otherName string, other T) Validatable {
+	switch op {
+	case "eq":
+		return EqCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "ne":
+		return NeCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "gt":
+		return GtCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "lt":
+		return LtCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "gte":
+		return GteCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	case "lte":
+		return LteCSField[T]{Name: name, Value: value, OtherName: otherName, Other: other}
+	default:
+		panic("validate: unknown CSFields op: " + op)
+	}
+}
+
+// fieldTagFunc validates fv, a field of the struct parent reached from root, against
+// the value another field resolves to. It differs from TagFunc in that cross-field
+// tags need the enclosing struct(s) to look up that other field.
+type fieldTagFunc func(fv, parent, root reflect.Value, param string) error
+
+var fieldTagFuncs = map[string]fieldTagFunc{}
+
+func init() {
+	ops := map[string]func(cmp int) bool{
+		"eq":  func(cmp int) bool { return cmp == 0 },
+		"ne":  func(cmp int) bool { return cmp != 0 },
+		"gt":  func(cmp int) bool { return cmp > 0 },
+		"lt":  func(cmp int) bool { return cmp < 0 },
+		"gte": func(cmp int) bool { return cmp >= 0 },
+		"lte": func(cmp int) bool { return cmp <= 0 },
+	}
+	descs := map[string]string{
+		"eq":  "is not equal to",
+		"ne":  "is equal to",
+		"gt":  "is not greater than",
+		"lt":  "is not smaller than",
+		"gte": "is not greater than or equal to",
+		"lte": "is not smaller than or equal to",
+	}
+	for op, pass := range ops {
+		fieldTagFuncs[op+"field"] = fieldCompareTag(pass, op+"field", descs[op], resolveSiblingField)
+		fieldTagFuncs[op+"csfield"] = fieldCompareTag(pass, op+"csfield", descs[op], resolveRootField)
+	}
+}
+
+// fieldCompareError is returned by the reflected *field/*csfield tag funcs. It
+// carries the same op tag and operands as the hand-written EqField/GtField/... types
+// so Fields()/describe() can report a real tag instead of falling back to the
+// lowercased type name of a plain fmt.Errorf.
+type fieldCompareError struct {
+	tag       string
+	desc      string
+	Value     any
+	OtherName string
+	Other     any
+}
+
+func (e fieldCompareError) Error() string {
+	return fmt.Sprintf("(%v) %s %s(%v)", e.Value, e.desc, e.OtherName, e.Other)
+}
+
+func (e fieldCompareError) Tag() string { return e.tag }
+
+// fieldCompareTag builds a fieldTagFunc that resolves the field named by param using
+// resolve, compares it against fv, and fails unless pass accepts the comparison sign.
+func fieldCompareTag(pass func(cmp int) bool, tag, desc string, resolve func(parent, root reflect.Value, param string) (reflect.Value, bool)) fieldTagFunc {
+	return func(fv, parent, root reflect.Value, param string) error {
+		other, ok := resolve(parent, root, param)
+		if !ok {
+			return fmt.Errorf("validate: field %q not found", param)
+		}
+		cmp, err := compareReflect(fv, other)
+		if err != nil {
+			return err
+		}
+		if !pass(cmp) {
+			return fieldCompareError{tag: tag, desc: desc, Value: fv.Interface(), OtherName: param, Other: other.Interface()}
+		}
+		return nil
+	}
+}
+
+// resolveSiblingField resolves name against the fields of parent, the struct
+// currently being validated, for tags such as "gtfield=Birthday".
+func resolveSiblingField(parent, root reflect.Value, name string) (reflect.Value, bool) {
+	f := parent.FieldByName(name)
+	return f, f.IsValid()
+}
+
+// resolveRootField resolves a dotted path such as "Parent.Field" starting from root,
+// the struct originally passed to Struct, for tags such as "gtcsfield=Parent.Field".
+func resolveRootField(parent, root reflect.Value, path string) (reflect.Value, bool) {
+	v := root
+	for _, part := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		v = v.FieldByName(part)
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	return v, true
+}
+
+// compareReflect compares two reflected values of the same type, returning a
+// negative, zero, or positive int as a < b, a == b, or a > b.
+func compareReflect(a, b reflect.Value) (int, error) {
+	if a.Type() != b.Type() {
+		return 0, fmt.Errorf("validate: cannot compare %s with %s", a.Type(), b.Type())
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case a.Int() < b.Int():
+			return -1, nil
+		case a.Int() > b.Int():
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch {
+		case a.Uint() < b.Uint():
+			return -1, nil
+		case a.Uint() > b.Uint():
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case a.Float() < b.Float():
+			return -1, nil
+		case a.Float() > b.Float():
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case reflect.String:
+		return strings.Compare(a.String(), b.String()), nil
+	case reflect.Struct:
+		if at, ok := a.Interface().(time.Time); ok {
+			return at.Compare(b.Interface().(time.Time)), nil
+		}
+	}
+	return 0, fmt.Errorf("validate: comparison not supported for kind %s", a.Kind())
+}
@@ -0,0 +1,141 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikolaydubina/validate"
+)
+
+func TestGtField(t *testing.T) {
+	tests := []struct {
+		name string
+		s    validate.GtField[int]
+		want bool
+	}{
+		{
+			name: "greater passes",
+			s:    validate.GtField[int]{Name: "salary", Value: 100, OtherName: "min_salary", Other: 50},
+			want: true,
+		},
+		{
+			name: "equal fails",
+			s:    validate.GtField[int]{Name: "salary", Value: 50, OtherName: "min_salary", Other: 50},
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.s.Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	mismatch := validate.Fields("password_confirm", "secret", "eq", "password", "sEcret")
+	if mismatch.Validate() == nil {
+		t.Fatal("got nil error, want mismatch to fail")
+	}
+
+	match := validate.Fields("password_confirm", "secret", "eq", "password", "secret")
+	if err := match.Validate(); err != nil {
+		t.Fatalf("got error %v, want nil for matching fields", err)
+	}
+}
+
+func TestFields_UnknownOp(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("want panic for unknown op")
+		}
+	}()
+	validate.Fields("a", 1, "wat", "b", 2)
+}
+
+type Account struct {
+	Password        string `validate:"min=6"`
+	PasswordConfirm string `validate:"eqfield=Password"`
+}
+
+type Trip struct {
+	Birthday      time.Time
+	VacationStart time.Time `validate:"gtfield=Birthday"`
+}
+
+type Org struct {
+	Founded time.Time
+}
+
+type Employee2 struct {
+	Org   Org
+	Hired time.Time `validate:"gtcsfield=Org.Founded"`
+}
+
+func TestStruct_FieldTags(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{
+			name: "eqfield passes",
+			v:    Account{Password: "secret", PasswordConfirm: "secret"},
+			want: "",
+		},
+		{
+			name: "eqfield fails",
+			v:    Account{Password: "secret", PasswordConfirm: "wrong!"},
+			want: "PasswordConfirm",
+		},
+		{
+			name: "gtfield passes",
+			v: Trip{
+				Birthday:      time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+				VacationStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "",
+		},
+		{
+			name: "gtfield fails",
+			v: Trip{
+				Birthday:      time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+				VacationStart: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "VacationStart",
+		},
+		{
+			name: "gtcsfield passes",
+			v: Employee2{
+				Org:   Org{Founded: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+				Hired: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "",
+		},
+		{
+			name: "gtcsfield fails",
+			v: Employee2{
+				Org:   Org{Founded: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+				Hired: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Hired",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.Struct(tc.v)
+			if tc.want == "" {
+				if err != nil {
+					t.Errorf("got error %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got %v, want error containing %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TagFunc validates a single reflected field against the text following "=" in a
+// `validate:"..."` struct tag (empty for bare tags such as "required").
+type TagFunc func(value reflect.Value, param string) error
+
+var tagFuncs = map[string]TagFunc{
+	"min":      tagMin,
+	"max":      tagMax,
+	"oneof":    tagOneOf,
+	"before":   tagBefore,
+	"after":    tagAfter,
+	"required": tagRequired,
+	"len":      tagLen,
+}
+
+// RegisterTagFunc registers fn as the handler for the given `validate` struct tag
+// name, so Struct applies it wherever the tag appears. It panics if tag is already
+// registered.
+func RegisterTagFunc(tag string, fn TagFunc) {
+	if _, ok := tagFuncs[tag]; ok {
+		panic("validate: tag already registered: " + tag)
+	}
+	if _, ok := fieldTagFuncs[tag]; ok {
+		panic("validate: tag already registered: " + tag)
+	}
+	tagFuncs[tag] = fn
+}
+
+// FieldError associates a validation failure with the reflected field path that
+// produced it, e.g. "Employee.Education.SchoolName".
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e FieldError) Error() string { return e.Path + ": " + e.Err.Error() }
+
+func (e FieldError) Unwrap() error { return e.Err }
+
+// Struct validates v by walking its fields via reflection and applying the
+// validators declared in `validate:"..."` struct tags. Nested structs are
+// validated by calling their Validate method if they implement Validatable,
+// otherwise by recursively walking their own tags. Slices, arrays, and maps
+// whose tag includes "dive" have each element validated against the tag rules
+// that follow "dive".
+func Struct(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validate: Struct requires a struct or pointer to struct, got %s", rv.Kind())
+	}
+	return validateStruct(rv, rv, rv.Type().Name())
+}
+
+func validateStruct(rv, root reflect.Value, path string) error {
+	var errs []error
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if err := validateField(rv.Field(i), field.Tag.Get("validate"), rv, root, path+"."+field.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// validateField applies tag to fv, a field of the struct parent, which in turn is
+// reached from root (the struct originally passed to Struct). parent and root are
+// only consulted by cross-field tags such as gtfield and gtcsfield.
+func validateField(fv reflect.Value, tag string, parent, root reflect.Value, path string) error {
+	var rules []string
+	if tag != "" {
+		rules = strings.Split(tag, ",")
+	}
+
+	diveAt := -1
+	for i, rule := range rules {
+		if rule == "dive" {
+			diveAt = i
+			break
+		}
+	}
+	containerRules, elemRules := rules, []string(nil)
+	if diveAt >= 0 {
+		containerRules, elemRules = rules[:diveAt], rules[diveAt+1:]
+	}
+
+	var errs []error
+	for _, rule := range containerRules {
+		name, param, _ := strings.Cut(rule, "=")
+		if fn, ok := fieldTagFuncs[name]; ok {
+			if err := fn(fv, parent, root, param); err != nil {
+				errs = append(errs, FieldError{Path: path, Err: err})
+			}
+			continue
+		}
+		if fn, ok := tagFuncs[name]; ok {
+			if err := fn(fv, param); err != nil {
+				errs = append(errs, FieldError{Path: path, Err: err})
+			}
+		}
+	}
+
+	if diveAt >= 0 {
+		if err := diveField(fv, strings.Join(elemRules, ","), parent, root, path); err != nil {
+			errs = append(errs, err)
+		}
+	} else if err := recurseField(fv, root, path); err != nil {
+		errs = append(errs, err)
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		// Return the lone failure directly rather than wrapping it in a
+		// ValidationError: validateStruct already collects one entry per field, so
+		// wrapping here would make every ordinary field failure look like a nested
+		// struct failure to Fields/MarshalJSON.
+		return errs[0]
+	default:
+		return ValidationError{Errors: errs}
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func recurseField(fv, root reflect.Value, path string) error {
+	for fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return nil
+		}
+		fv = fv.Elem()
+	}
+	if fv.Kind() != reflect.Struct || fv.Type() == timeType {
+		return nil
+	}
+	if v, ok := fv.Interface().(Validatable); ok {
+		if err := v.Validate(); err != nil {
+			return FieldError{Path: path, Err: err}
+		}
+		return nil
+	}
+	return validateStruct(fv, root, path)
+}
+
+func diveField(fv reflect.Value, elemTag string, parent, root reflect.Value, path string) error {
+	for fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return nil
+		}
+		fv = fv.Elem()
+	}
+
+	var errs []error
+	switch fv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < fv.Len(); i++ {
+			if err := validateField(fv.Index(i), elemTag, parent, root, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	case reflect.Map:
+		for _, k := range fv.MapKeys() {
+			if err := validateField(fv.MapIndex(k), elemTag, parent, root, fmt.Sprintf("%s[%v]", path, k.Interface())); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func tagMin(fv reflect.Value, param string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("validate: bad min param %q: %w", param, err)
+		}
+		return Min[int]{Value: utf8.RuneCountInString(fv.String()), Min: n}.Validate()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad min param %q: %w", param, err)
+		}
+		return Min[int64]{Value: fv.Int(), Min: n}.Validate()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad min param %q: %w", param, err)
+		}
+		return Min[uint64]{Value: fv.Uint(), Min: n}.Validate()
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad min param %q: %w", param, err)
+		}
+		return Min[float64]{Value: fv.Float(), Min: n}.Validate()
+	case reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("validate: bad min param %q: %w", param, err)
+		}
+		return Min[int]{Value: fv.Len(), Min: n}.Validate()
+	default:
+		return fmt.Errorf("validate: min not supported for kind %s", fv.Kind())
+	}
+}
+
+func tagMax(fv reflect.Value, param string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("validate: bad max param %q: %w", param, err)
+		}
+		return Max[int]{Value: utf8.RuneCountInString(fv.String()), Max: n}.Validate()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad max param %q: %w", param, err)
+		}
+		return Max[int64]{Value: fv.Int(), Max: n}.Validate()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad max param %q: %w", param, err)
+		}
+		return Max[uint64]{Value: fv.Uint(), Max: n}.Validate()
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Errorf("validate: bad max param %q: %w", param, err)
+		}
+		return Max[float64]{Value: fv.Float(), Max: n}.Validate()
+	case reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("validate: bad max param %q: %w", param, err)
+		}
+		return Max[int]{Value: fv.Len(), Max: n}.Validate()
+	default:
+		return fmt.Errorf("validate: max not supported for kind %s", fv.Kind())
+	}
+}
+
+func tagOneOf(fv reflect.Value, param string) error {
+	values := strings.Fields(param)
+	switch fv.Kind() {
+	case reflect.String:
+		return OneOf[string]{Value: fv.String(), Values: values}.Validate()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		ints := make([]int64, 0, len(values))
+		for _, v := range values {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("validate: bad oneof param %q: %w", v, err)
+			}
+			ints = append(ints, n)
+		}
+		return OneOf[int64]{Value: fv.Int(), Values: ints}.Validate()
+	default:
+		return fmt.Errorf("validate: oneof not supported for kind %s", fv.Kind())
+	}
+}
+
+const tagDateLayout = "2006-01-02"
+
+func tagBefore(fv reflect.Value, param string) error {
+	t, ok := fv.Interface().(time.Time)
+	if !ok {
+		return fmt.Errorf("validate: before not supported for kind %s", fv.Kind())
+	}
+	cutoff, err := time.Parse(tagDateLayout, param)
+	if err != nil {
+		return fmt.Errorf("validate: bad before param %q: %w", param, err)
+	}
+	return Before{Value: t, Time: cutoff}.Validate()
+}
+
+func tagAfter(fv reflect.Value, param string) error {
+	t, ok := fv.Interface().(time.Time)
+	if !ok {
+		return fmt.Errorf("validate: after not supported for kind %s", fv.Kind())
+	}
+	cutoff, err := time.Parse(tagDateLayout, param)
+	if err != nil {
+		return fmt.Errorf("validate: bad after param %q: %w", param, err)
+	}
+	return After{Value: t, Time: cutoff}.Validate()
+}
+
+// Required reports that a field validated via Struct's "required" tag was left at
+// its zero value.
+type Required struct {
+	Value any
+}
+
+func (s Required) Error() string { return "is required" }
+
+func (s Required) Tag() string { return "required" }
+
+func tagRequired(fv reflect.Value, _ string) error {
+	if fv.IsZero() {
+		return Required{Value: fv.Interface()}
+	}
+	return nil
+}
+
+func tagLen(fv reflect.Value, param string) error {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		return fmt.Errorf("validate: bad len param %q: %w", param, err)
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		return Len{Value: fv.String(), N: n}.Validate()
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if fv.Len() != n {
+			return fmt.Errorf("length(%d) is not equal to len(%d)", fv.Len(), n)
+		}
+		return nil
+	default:
+		return fmt.Errorf("validate: len not supported for kind %s", fv.Kind())
+	}
+}
@@ -0,0 +1,123 @@
+package validate_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nikolaydubina/validate"
+)
+
+// Candidate is a tag-driven equivalent of Education/Employee used to exercise Struct.
+type Candidate struct {
+	Name    string    `validate:"required,min=2,max=10"`
+	Age     int       `validate:"min=18,max=65"`
+	Role    string    `validate:"oneof=engineer manager"`
+	Hired   time.Time `validate:"after=2020-01-01"`
+	Tags    []string  `validate:"dive,min=1"`
+	Degrees []Degree  `validate:"dive"`
+}
+
+type Degree struct {
+	School string `validate:"oneof=KAIST Stanford"`
+}
+
+func TestStruct(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Candidate
+		want string
+	}{
+		{
+			name: "valid",
+			c: Candidate{
+				Name:  "Bob",
+				Age:   30,
+				Role:  "engineer",
+				Hired: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Tags:  []string{"go"},
+				Degrees: []Degree{
+					{School: "KAIST"},
+				},
+			},
+			want: "",
+		},
+		{
+			name: "required fails",
+			c: Candidate{
+				Age:   30,
+				Role:  "engineer",
+				Hired: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Candidate.Name: is required",
+		},
+		{
+			name: "nested struct fails",
+			c: Candidate{
+				Name:  "Bob",
+				Age:   30,
+				Role:  "engineer",
+				Hired: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Degrees: []Degree{
+					{School: "Berkeley"},
+				},
+			},
+			want: "Candidate.Degrees[0].School",
+		},
+		{
+			name: "dive fails",
+			c: Candidate{
+				Name:  "Bob",
+				Age:   30,
+				Role:  "engineer",
+				Hired: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Tags:  []string{""},
+			},
+			want: "Candidate.Tags[0]",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.Struct(tc.c)
+			if tc.want == "" {
+				if err != nil {
+					t.Errorf("got error %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("got nil error, want containing %q", tc.want)
+			}
+			if got := err.Error(); !strings.Contains(got, tc.want) {
+				t.Errorf("got %q, want containing %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStruct_NotAStruct(t *testing.T) {
+	if err := validate.Struct(42); err == nil {
+		t.Fatal("got nil error, want error for non-struct input")
+	}
+}
+
+func TestRegisterTagFunc(t *testing.T) {
+	type Even struct {
+		N int `validate:"even=1"`
+	}
+	validate.RegisterTagFunc("even", func(v reflect.Value, param string) error {
+		if v.Int()%2 != 0 {
+			return errors.New("must be even")
+		}
+		return nil
+	})
+
+	if err := validate.Struct(Even{N: 3}); err == nil {
+		t.Fatal("got nil error, want odd number to fail custom tag")
+	}
+	if err := validate.Struct(Even{N: 4}); err != nil {
+		t.Fatalf("got error %v, want nil for even number", err)
+	}
+}
@@ -0,0 +1,376 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Len reports a failure when Value's rune count does not equal N.
+type Len struct {
+	Name  string
+	Value string
+	N     int
+}
+
+func (s Len) Validate() error {
+	if utf8.RuneCountInString(s.Value) != s.N {
+		return s
+	}
+	return nil
+}
+
+func (s Len) Error() string {
+	return fmt.Sprintf("%s(%v) length is not %d", s.Name, s.Value, s.N)
+}
+
+func (s Len) Tag() string { return "len" }
+
+var regexCache sync.Map // pattern string -> *regexp.Regexp
+
+// compileCached compiles pattern, or returns the *regexp.Regexp already compiled for
+// it, so repeatedly validating the same pattern (e.g. the same struct tag) doesn't
+// pay recompilation cost on the hot path.
+func compileCached(pattern string) *regexp.Regexp {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(pattern)
+	regexCache.Store(pattern, re)
+	return re
+}
+
+// Regex reports a failure when Value does not match Pattern.
+type Regex struct {
+	Name    string
+	Value   string
+	Pattern string
+}
+
+func (s Regex) Validate() error {
+	if !compileCached(s.Pattern).MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Regex) Error() string {
+	return fmt.Sprintf("%s(%v) does not match pattern(%s)", s.Name, s.Value, s.Pattern)
+}
+
+func (s Regex) Tag() string { return "regex" }
+
+var (
+	emailPattern        = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$`)
+	uuidPattern         = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	alphaPattern        = regexp.MustCompile(`^[a-zA-Z]+$`)
+	alphanumericPattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	base64Pattern       = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+	hostnamePattern     = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+)
+
+// Email reports a failure when Value is not a syntactically valid email address.
+type Email struct {
+	Name  string
+	Value string
+}
+
+func (s Email) Validate() error {
+	if !emailPattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Email) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid email", s.Name, s.Value)
+}
+
+func (s Email) Tag() string { return "email" }
+
+// URL reports a failure when Value is not an absolute URL with a scheme and host.
+type URL struct {
+	Name  string
+	Value string
+}
+
+func (s URL) Validate() error {
+	u, err := url.ParseRequestURI(s.Value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+	return nil
+}
+
+func (s URL) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid URL", s.Name, s.Value)
+}
+
+func (s URL) Tag() string { return "url" }
+
+// UUID reports a failure when Value is not a canonically formatted UUID.
+type UUID struct {
+	Name  string
+	Value string
+}
+
+func (s UUID) Validate() error {
+	if !uuidPattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s UUID) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid UUID", s.Name, s.Value)
+}
+
+func (s UUID) Tag() string { return "uuid" }
+
+// IP reports a failure when Value is not a valid IPv4 or IPv6 address.
+type IP struct {
+	Name  string
+	Value string
+}
+
+func (s IP) Validate() error {
+	if net.ParseIP(s.Value) == nil {
+		return s
+	}
+	return nil
+}
+
+func (s IP) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid IP address", s.Name, s.Value)
+}
+
+func (s IP) Tag() string { return "ip" }
+
+// IPv4 reports a failure when Value is not a valid IPv4 address.
+type IPv4 struct {
+	Name  string
+	Value string
+}
+
+func (s IPv4) Validate() error {
+	if ip := net.ParseIP(s.Value); ip == nil || ip.To4() == nil {
+		return s
+	}
+	return nil
+}
+
+func (s IPv4) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid IPv4 address", s.Name, s.Value)
+}
+
+func (s IPv4) Tag() string { return "ipv4" }
+
+// IPv6 reports a failure when Value is not a valid IPv6 address.
+type IPv6 struct {
+	Name  string
+	Value string
+}
+
+func (s IPv6) Validate() error {
+	if ip := net.ParseIP(s.Value); ip == nil || ip.To4() != nil {
+		return s
+	}
+	return nil
+}
+
+func (s IPv6) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid IPv6 address", s.Name, s.Value)
+}
+
+func (s IPv6) Tag() string { return "ipv6" }
+
+// Hostname reports a failure when Value is not a valid RFC 1123 hostname.
+type Hostname struct {
+	Name  string
+	Value string
+}
+
+func (s Hostname) Validate() error {
+	if len(s.Value) > 253 || !hostnamePattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Hostname) Error() string {
+	return fmt.Sprintf("%s(%v) is not a valid hostname", s.Name, s.Value)
+}
+
+func (s Hostname) Tag() string { return "hostname" }
+
+// Alpha reports a failure when Value contains anything other than ASCII letters.
+type Alpha struct {
+	Name  string
+	Value string
+}
+
+func (s Alpha) Validate() error {
+	if !alphaPattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Alpha) Error() string {
+	return fmt.Sprintf("%s(%v) is not alphabetic", s.Name, s.Value)
+}
+
+func (s Alpha) Tag() string { return "alpha" }
+
+// Alphanumeric reports a failure when Value contains anything other than ASCII
+// letters and digits.
+type Alphanumeric struct {
+	Name  string
+	Value string
+}
+
+func (s Alphanumeric) Validate() error {
+	if !alphanumericPattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Alphanumeric) Error() string {
+	return fmt.Sprintf("%s(%v) is not alphanumeric", s.Name, s.Value)
+}
+
+func (s Alphanumeric) Tag() string { return "alphanum" }
+
+// Base64 reports a failure when Value is not validly base64-encoded.
+type Base64 struct {
+	Name  string
+	Value string
+}
+
+func (s Base64) Validate() error {
+	if !base64Pattern.MatchString(s.Value) {
+		return s
+	}
+	return nil
+}
+
+func (s Base64) Error() string {
+	return fmt.Sprintf("%s(%v) is not valid base64", s.Name, s.Value)
+}
+
+func (s Base64) Tag() string { return "base64" }
+
+// ContainsAny reports a failure when Value contains none of the characters in Chars.
+type ContainsAny struct {
+	Name  string
+	Value string
+	Chars string
+}
+
+func (s ContainsAny) Validate() error {
+	if !strings.ContainsAny(s.Value, s.Chars) {
+		return s
+	}
+	return nil
+}
+
+func (s ContainsAny) Error() string {
+	return fmt.Sprintf("%s(%v) does not contain any of %q", s.Name, s.Value, s.Chars)
+}
+
+func (s ContainsAny) Tag() string { return "containsany" }
+
+// ExcludesAny reports a failure when Value contains any of the characters in Chars.
+type ExcludesAny struct {
+	Name  string
+	Value string
+	Chars string
+}
+
+func (s ExcludesAny) Validate() error {
+	if strings.ContainsAny(s.Value, s.Chars) {
+		return s
+	}
+	return nil
+}
+
+func (s ExcludesAny) Error() string {
+	return fmt.Sprintf("%s(%v) contains an excluded character from %q", s.Name, s.Value, s.Chars)
+}
+
+func (s ExcludesAny) Tag() string { return "excludesany" }
+
+// StartsWith reports a failure when Value does not start with Prefix.
+type StartsWith struct {
+	Name   string
+	Value  string
+	Prefix string
+}
+
+func (s StartsWith) Validate() error {
+	if !strings.HasPrefix(s.Value, s.Prefix) {
+		return s
+	}
+	return nil
+}
+
+func (s StartsWith) Error() string {
+	return fmt.Sprintf("%s(%v) does not start with %q", s.Name, s.Value, s.Prefix)
+}
+
+func (s StartsWith) Tag() string { return "startswith" }
+
+// EndsWith reports a failure when Value does not end with Suffix.
+type EndsWith struct {
+	Name   string
+	Value  string
+	Suffix string
+}
+
+func (s EndsWith) Validate() error {
+	if !strings.HasSuffix(s.Value, s.Suffix) {
+		return s
+	}
+	return nil
+}
+
+func (s EndsWith) Error() string {
+	return fmt.Sprintf("%s(%v) does not end with %q", s.Name, s.Value, s.Suffix)
+}
+
+func (s EndsWith) Tag() string { return "endswith" }
+
+// tagStringValidator adapts build, which constructs one of this file's string
+// validators from a field's value and the tag's param, into a TagFunc.
+func tagStringValidator(build func(value, param string) Validatable) TagFunc {
+	return func(fv reflect.Value, param string) error {
+		if fv.Kind() != reflect.String {
+			return fmt.Errorf("validate: tag not supported for kind %s", fv.Kind())
+		}
+		return build(fv.String(), param).Validate()
+	}
+}
+
+func init() {
+	tagFuncs["regex"] = tagStringValidator(func(v, p string) Validatable { return Regex{Value: v, Pattern: p} })
+	tagFuncs["email"] = tagStringValidator(func(v, _ string) Validatable { return Email{Value: v} })
+	tagFuncs["url"] = tagStringValidator(func(v, _ string) Validatable { return URL{Value: v} })
+	tagFuncs["uuid"] = tagStringValidator(func(v, _ string) Validatable { return UUID{Value: v} })
+	tagFuncs["ip"] = tagStringValidator(func(v, _ string) Validatable { return IP{Value: v} })
+	tagFuncs["ipv4"] = tagStringValidator(func(v, _ string) Validatable { return IPv4{Value: v} })
+	tagFuncs["ipv6"] = tagStringValidator(func(v, _ string) Validatable { return IPv6{Value: v} })
+	tagFuncs["hostname"] = tagStringValidator(func(v, _ string) Validatable { return Hostname{Value: v} })
+	tagFuncs["alpha"] = tagStringValidator(func(v, _ string) Validatable { return Alpha{Value: v} })
+	tagFuncs["alphanum"] = tagStringValidator(func(v, _ string) Validatable { return Alphanumeric{Value: v} })
+	tagFuncs["base64"] = tagStringValidator(func(v, _ string) Validatable { return Base64{Value: v} })
+	tagFuncs["containsany"] = tagStringValidator(func(v, p string) Validatable { return ContainsAny{Value: v, Chars: p} })
+	tagFuncs["excludesany"] = tagStringValidator(func(v, p string) Validatable { return ExcludesAny{Value: v, Chars: p} })
+	tagFuncs["startswith"] = tagStringValidator(func(v, p string) Validatable { return StartsWith{Value: v, Prefix: p} })
+	tagFuncs["endswith"] = tagStringValidator(func(v, p string) Validatable { return EndsWith{Value: v, Suffix: p} })
+}
@@ -0,0 +1,82 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/nikolaydubina/validate"
+)
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name string
+		v    validate.Validatable
+		want bool
+	}{
+		{"len ok", validate.Len{Value: "hello", N: 5}, true},
+		{"len fail", validate.Len{Value: "hello", N: 4}, false},
+		{"regex ok", validate.Regex{Value: "abc123", Pattern: `^[a-z]+\d+$`}, true},
+		{"regex fail", validate.Regex{Value: "ABC", Pattern: `^[a-z]+\d+$`}, false},
+		{"email ok", validate.Email{Value: "alice@example.com"}, true},
+		{"email fail", validate.Email{Value: "not-an-email"}, false},
+		{"url ok", validate.URL{Value: "https://example.com/path"}, true},
+		{"url fail", validate.URL{Value: "not a url"}, false},
+		{"uuid ok", validate.UUID{Value: "123e4567-e89b-12d3-a456-426614174000"}, true},
+		{"uuid fail", validate.UUID{Value: "not-a-uuid"}, false},
+		{"ip ok", validate.IP{Value: "192.168.0.1"}, true},
+		{"ip fail", validate.IP{Value: "bogus"}, false},
+		{"ipv4 ok", validate.IPv4{Value: "192.168.0.1"}, true},
+		{"ipv4 fail", validate.IPv4{Value: "::1"}, false},
+		{"ipv6 ok", validate.IPv6{Value: "::1"}, true},
+		{"ipv6 fail", validate.IPv6{Value: "192.168.0.1"}, false},
+		{"hostname ok", validate.Hostname{Value: "sub.example.com"}, true},
+		{"hostname fail", validate.Hostname{Value: "not_a_host!"}, false},
+		{"alpha ok", validate.Alpha{Value: "abcXYZ"}, true},
+		{"alpha fail", validate.Alpha{Value: "abc123"}, false},
+		{"alphanum ok", validate.Alphanumeric{Value: "abc123"}, true},
+		{"alphanum fail", validate.Alphanumeric{Value: "abc-123"}, false},
+		{"base64 ok", validate.Base64{Value: "aGVsbG8="}, true},
+		{"base64 fail", validate.Base64{Value: "not base64!"}, false},
+		{"containsany ok", validate.ContainsAny{Value: "hello", Chars: "xyzh"}, true},
+		{"containsany fail", validate.ContainsAny{Value: "hello", Chars: "xyz"}, false},
+		{"excludesany ok", validate.ExcludesAny{Value: "hello", Chars: "xyz"}, true},
+		{"excludesany fail", validate.ExcludesAny{Value: "hello", Chars: "xyzh"}, false},
+		{"startswith ok", validate.StartsWith{Value: "hello world", Prefix: "hello"}, true},
+		{"startswith fail", validate.StartsWith{Value: "hello world", Prefix: "world"}, false},
+		{"endswith ok", validate.EndsWith{Value: "hello world", Suffix: "world"}, true},
+		{"endswith fail", validate.EndsWith{Value: "hello world", Suffix: "hello"}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.v.Validate()
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
+
+type Contact struct {
+	Email    string `validate:"email"`
+	Website  string `validate:"url"`
+	Username string `validate:"alphanum,len=5"`
+}
+
+func TestStruct_StringTags(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Contact
+		want bool
+	}{
+		{"valid", Contact{Email: "bob@example.com", Website: "https://example.com", Username: "bob12"}, true},
+		{"bad email", Contact{Email: "nope", Website: "https://example.com", Username: "bob12"}, false},
+		{"bad username length", Contact{Email: "bob@example.com", Website: "https://example.com", Username: "bob"}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate.Struct(tc.c)
+			if (err == nil) != tc.want {
+				t.Errorf("got error %v, want pass=%v", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Translator renders a validator's failure as a message in some language, given the
+// failed field's stable tag (e.g. "min") and the params a Field carries for it: in
+// this package, always (field, value, param) in that order.
+type Translator interface {
+	Translate(tag string, params ...any) string
+}
+
+// EnglishTranslator is the default Translator. For min, max, oneof, before, and
+// after its output matches the corresponding validator's own Error() string, with
+// the Field path in place of Name. For the *field/*csfield comparison tags,
+// Translate only has the other field's current value on hand, not its name, so it
+// renders "X(v) is not greater than Other" rather than "X(v) is not greater than
+// OtherName(Other)". Every other tag (required, len, the string-format tags, and
+// custom tags) renders as "X(v) is invalid".
+type EnglishTranslator struct{}
+
+func (EnglishTranslator) Translate(tag string, params ...any) string {
+	field, value, param := params[0], params[1], params[2]
+	switch tag {
+	case "min":
+		return fmt.Sprintf("%v(%v) smaller than min(%v)", field, value, param)
+	case "max":
+		return fmt.Sprintf("%v(%v) higher than max(%v)", field, value, param)
+	case "oneof":
+		return fmt.Sprintf("%v(%v) is not in %v", field, value, param)
+	case "before":
+		return fmt.Sprintf("%v(%v) is not before (%v)", field, value, param)
+	case "after":
+		return fmt.Sprintf("%v(%v) is not after (%v)", field, value, param)
+	case "eqfield", "eqcsfield":
+		return fmt.Sprintf("%v(%v) is not equal to %v", field, value, param)
+	case "nefield", "necsfield":
+		return fmt.Sprintf("%v(%v) is equal to %v", field, value, param)
+	case "gtfield", "gtcsfield":
+		return fmt.Sprintf("%v(%v) is not greater than %v", field, value, param)
+	case "ltfield", "ltcsfield":
+		return fmt.Sprintf("%v(%v) is not smaller than %v", field, value, param)
+	case "gtefield", "gtecsfield":
+		return fmt.Sprintf("%v(%v) is not greater than or equal to %v", field, value, param)
+	case "ltefield", "ltecsfield":
+		return fmt.Sprintf("%v(%v) is not smaller than or equal to %v", field, value, param)
+	default:
+		return fmt.Sprintf("%v(%v) is invalid", field, value)
+	}
+}
+
+var translators = map[string]Translator{
+	"en": EnglishTranslator{},
+}
+
+// RegisterTranslator registers t as the Translator for locale (e.g. "ja", "fr"), so
+// that TranslatorFor(locale) returns it without modifying the core validators.
+func RegisterTranslator(locale string, t Translator) {
+	translators[locale] = t
+}
+
+// TranslatorFor returns the Translator registered for locale, or EnglishTranslator
+// if none is registered.
+func TranslatorFor(locale string) Translator {
+	if t, ok := translators[locale]; ok {
+		return t
+	}
+	return EnglishTranslator{}
+}
+
+// TranslatedError renders e using t instead of each validator's own Error() string,
+// in the same "validate: N errors: [...]" shape. Nested failures are flattened into
+// the one list, so N counts every leaf failure rather than e's top-level errors.
+func (e ValidationError) TranslatedError(t Translator) string {
+	msgs := translateFields(e.Fields(), t)
+	return "validate: " + strconv.Itoa(len(msgs)) + " errors: [" + strings.Join(msgs, "; ") + "]"
+}
+
+func translateFields(fields []Field, t Translator) []string {
+	var msgs []string
+	for _, f := range fields {
+		if f.Kind == KindNested {
+			msgs = append(msgs, translateFields(f.Nested, t)...)
+			continue
+		}
+		msgs = append(msgs, t.Translate(f.Tag, f.Field, f.Value, f.Param))
+	}
+	return msgs
+}
@@ -0,0 +1,46 @@
+package validate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nikolaydubina/validate"
+)
+
+func TestValidationError_TranslatedError(t *testing.T) {
+	err := validate.All(validate.Min[int]{Name: "age", Value: 5, Min: 10})
+	ve := err.(validate.ValidationError)
+
+	got := ve.TranslatedError(validate.EnglishTranslator{})
+	want := ve.Error()
+	if got != want {
+		t.Errorf("got %q, want %q (EnglishTranslator should match Error())", got, want)
+	}
+}
+
+type frenchTranslator struct{}
+
+func (frenchTranslator) Translate(tag string, params ...any) string {
+	field, value, param := params[0], params[1], params[2]
+	if tag == "min" {
+		return fmt.Sprintf("%v(%v) est inférieur au minimum(%v)", field, value, param)
+	}
+	return fmt.Sprintf("%v(%v) est invalide", field, value)
+}
+
+func TestRegisterTranslator(t *testing.T) {
+	validate.RegisterTranslator("fr", frenchTranslator{})
+
+	err := validate.All(validate.Min[int]{Name: "age", Value: 5, Min: 10})
+	ve := err.(validate.ValidationError)
+
+	got := ve.TranslatedError(validate.TranslatorFor("fr"))
+	want := "validate: 1 errors: [age(5) est inférieur au minimum(10)]"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, ok := validate.TranslatorFor("de").(validate.EnglishTranslator); !ok {
+		t.Error("got non-English translator for unregistered locale, want fallback to EnglishTranslator")
+	}
+}
@@ -55,6 +55,8 @@ func (s Min[T]) Error() string {
 	return fmt.Sprintf("%s(%v) smaller than min(%v)", s.Name, s.Value, s.Min)
 }
 
+func (s Min[T]) Tag() string { return "min" }
+
 type Max[T constraints.Ordered] struct {
 	Name  string
 	Value T
@@ -72,6 +74,8 @@ func (s Max[T]) Error() string {
 	return fmt.Sprintf("%s(%v) higher than max(%v)", s.Name, s.Value, s.Max)
 }
 
+func (s Max[T]) Tag() string { return "max" }
+
 type OneOf[T comparable] struct {
 	Name   string
 	Value  T
@@ -91,6 +95,8 @@ func (s OneOf[T]) Error() string {
 	return fmt.Sprintf("%s(%v) is not in %v", s.Name, s.Value, s.Values)
 }
 
+func (s OneOf[T]) Tag() string { return "oneof" }
+
 type Before struct {
 	Name  string
 	Value time.Time
@@ -108,6 +114,8 @@ func (s Before) Error() string {
 	return fmt.Sprintf("%s(%v) is not before (%v)", s.Name, s.Value, s.Time)
 }
 
+func (s Before) Tag() string { return "before" }
+
 type After struct {
 	Name  string
 	Value time.Time
@@ -124,3 +132,5 @@ func (s After) Validate() error {
 func (s After) Error() string {
 	return fmt.Sprintf("%s(%v) is not after (%v)", s.Name, s.Value, s.Time)
 }
+
+func (s After) Tag() string { return "after" }